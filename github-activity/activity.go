@@ -0,0 +1,49 @@
+package main
+
+import "fmt"
+
+// Activity is implemented by each supported forge backend. Fetch returns the
+// user's recent activity normalized into the shared Event shape, so
+// formatting, caching and filtering never need to know which forge produced
+// it.
+type Activity interface {
+	Fetch(username string) ([]Event, error)
+}
+
+// activityOptions carries the flags that only some forge backends use, so
+// adding one more doesn't mean changing newActivity's signature again.
+type activityOptions struct {
+	Wait bool // GitHub only: block until the rate limit resets instead of erroring.
+	Max  int  // GitHub only: extra cap on events returned.
+}
+
+// newActivity builds the Activity implementation for the named forge,
+// defaulting host to that forge's public instance when host is left empty.
+// Self-hosted forges (gitea, gerrit) have no meaningful default and require
+// --host.
+func newActivity(forge, host string, opts activityOptions) (Activity, error) {
+	switch forge {
+	case "", "github":
+		if host == "" {
+			host = "api.github.com"
+		}
+		return &githubActivity{host: host, token: githubToken(), wait: opts.Wait, max: opts.Max}, nil
+	case "gitlab":
+		if host == "" {
+			host = "gitlab.com"
+		}
+		return &gitlabActivity{host: host}, nil
+	case "gitea":
+		if host == "" {
+			return nil, fmt.Errorf("--host is required for --forge=gitea")
+		}
+		return &giteaActivity{host: host}, nil
+	case "gerrit":
+		if host == "" {
+			return nil, fmt.Errorf("--host is required for --forge=gerrit")
+		}
+		return &gerritActivity{host: host}, nil
+	default:
+		return nil, fmt.Errorf("unknown forge %q (want github, gitlab, gitea, or gerrit)", forge)
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// githubToken resolves the token to authenticate GitHub API requests with,
+// preferring the GITHUB_TOKEN env var (so CI and one-off overrides win) and
+// falling back to ~/.config/github-activity/token. Returns "" if neither is
+// set, in which case requests go out unauthenticated.
+func githubToken() string {
+	if tok := os.Getenv("GITHUB_TOKEN"); tok != "" {
+		return tok
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(home, ".config", "github-activity", "token"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// cacheEntry is what we persist per request URL: the raw response body plus
+// enough of the validator headers to make a conditional request next time.
+type cacheEntry struct {
+	ETag         string          `json:"etag,omitempty"`
+	LastModified string          `json:"last_modified,omitempty"`
+	PollInterval int             `json:"poll_interval_seconds,omitempty"` // From X-Poll-Interval.
+	FetchedAt    time.Time       `json:"fetched_at"`
+	Body         json.RawMessage `json:"body"`
+}
+
+// cacheDir returns ~/.cache/github-activity (or the platform equivalent via
+// os.UserCacheDir), creating it if necessary.
+func cacheDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(base, "github-activity")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// cachePath returns the on-disk path for the given request URL, keyed by its
+// sha256 hash so arbitrary query strings are safe as filenames.
+func cachePath(url string) (string, error) {
+	dir, err := cacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json"), nil
+}
+
+// loadCacheEntry reads the cached entry for url, returning (nil, nil) if
+// nothing is cached yet.
+func loadCacheEntry(url string) (*cacheEntry, error) {
+	path, err := cachePath(url)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		// Corrupt cache file; treat as a cache miss rather than failing the run.
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+// saveCacheEntry writes entry for url, overwriting whatever was cached
+// before.
+func saveCacheEntry(url string, entry cacheEntry) error {
+	path, err := cachePath(url)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
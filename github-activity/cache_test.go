@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCachePathIsStableAndURLKeyed(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	p1, err := cachePath("https://api.github.com/users/octocat/events")
+	if err != nil {
+		t.Fatalf("cachePath: %v", err)
+	}
+	p2, err := cachePath("https://api.github.com/users/octocat/events")
+	if err != nil {
+		t.Fatalf("cachePath: %v", err)
+	}
+	if p1 != p2 {
+		t.Errorf("cachePath not stable for the same URL: %q != %q", p1, p2)
+	}
+
+	p3, err := cachePath("https://api.github.com/users/someoneelse/events")
+	if err != nil {
+		t.Fatalf("cachePath: %v", err)
+	}
+	if p1 == p3 {
+		t.Errorf("cachePath collided for different URLs: %q", p1)
+	}
+}
+
+func TestLoadCacheEntryMissingIsNilNil(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	entry, err := loadCacheEntry("https://api.github.com/users/nobody/events")
+	if err != nil {
+		t.Fatalf("loadCacheEntry: %v", err)
+	}
+	if entry != nil {
+		t.Errorf("got %+v, want nil for an uncached URL", entry)
+	}
+}
+
+func TestSaveAndLoadCacheEntryRoundTrips(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	url := "https://api.github.com/users/octocat/events"
+
+	want := cacheEntry{
+		ETag:         `"abc123"`,
+		LastModified: "Wed, 21 Oct 2015 07:28:00 GMT",
+		PollInterval: 60,
+		FetchedAt:    time.Now().UTC().Truncate(time.Second),
+		Body:         json.RawMessage(`[{"id":"1"}]`),
+	}
+	if err := saveCacheEntry(url, want); err != nil {
+		t.Fatalf("saveCacheEntry: %v", err)
+	}
+
+	got, err := loadCacheEntry(url)
+	if err != nil {
+		t.Fatalf("loadCacheEntry: %v", err)
+	}
+	if got == nil {
+		t.Fatal("loadCacheEntry returned nil after save")
+	}
+	if got.ETag != want.ETag || got.LastModified != want.LastModified || got.PollInterval != want.PollInterval {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if !got.FetchedAt.Equal(want.FetchedAt) {
+		t.Errorf("FetchedAt = %v, want %v", got.FetchedAt, want.FetchedAt)
+	}
+}
+
+func TestLoadCacheEntryCorruptFileIsCacheMiss(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	url := "https://api.github.com/users/octocat/events"
+
+	path, err := cachePath(url)
+	if err != nil {
+		t.Fatalf("cachePath: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("{not valid json"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	entry, err := loadCacheEntry(url)
+	if err != nil {
+		t.Fatalf("loadCacheEntry: %v", err)
+	}
+	if entry != nil {
+		t.Errorf("got %+v, want nil for a corrupt cache file", entry)
+	}
+}
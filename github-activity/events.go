@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Payload types below mirror the subset of GitHub's event payloads that
+// github-activity knows how to render, one struct per event type. Only the
+// fields formatEvent actually needs are kept; see
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads for the
+// full shapes.
+
+type PushPayload struct {
+	Commits []struct {
+		SHA string `json:"sha"`
+	} `json:"commits"`
+}
+
+type IssuesPayload struct {
+	Action string `json:"action"` // "opened", "closed", "reopened", etc.
+}
+
+type IssueCommentPayload struct {
+	Action  string `json:"action"` // "created", "edited", "deleted".
+	Comment struct {
+		Body string `json:"body"`
+	} `json:"comment"`
+}
+
+type PullRequestPayload struct {
+	Action      string `json:"action"` // "opened", "closed", "reopened", etc.
+	PullRequest struct {
+		Merged bool `json:"merged"`
+	} `json:"pull_request"`
+}
+
+type PullRequestReviewPayload struct {
+	Action string `json:"action"`
+	Review struct {
+		State string `json:"state"` // "approved", "changes_requested", "commented".
+	} `json:"review"`
+}
+
+type PullRequestReviewCommentPayload struct {
+	Action  string `json:"action"`
+	Comment struct {
+		Body string `json:"body"`
+	} `json:"comment"`
+}
+
+type CreatePayload struct {
+	RefType string `json:"ref_type"` // "repository", "branch", "tag".
+	Ref     string `json:"ref"`      // Name of branch/tag created (empty for repo sometimes).
+}
+
+type DeletePayload struct {
+	RefType string `json:"ref_type"` // "branch" or "tag".
+	Ref     string `json:"ref"`
+}
+
+type CommitCommentPayload struct {
+	Comment struct {
+		Body string `json:"body"`
+	} `json:"comment"`
+}
+
+type GollumPayload struct {
+	Pages []struct {
+		PageName string `json:"page_name"`
+		Action   string `json:"action"` // "created" or "edited".
+	} `json:"pages"`
+}
+
+type MemberPayload struct {
+	Action string `json:"action"` // "added", "removed", "edited".
+	Member struct {
+		Login string `json:"login"`
+	} `json:"member"`
+}
+
+// PublicPayload has no fields: GitHub sends an empty object when a private
+// repo is made public.
+type PublicPayload struct{}
+
+type ReleasePayload struct {
+	Action  string `json:"action"`
+	Release struct {
+		TagName string `json:"tag_name"`
+	} `json:"release"`
+}
+
+// ParsePayload decodes an event's raw payload into its typed struct based on
+// the GitHub event type name, the way go-github's activity_events.go picks a
+// concrete type per event. It returns (nil, nil) for event types we don't
+// model, so callers can fall back to a generic rendering, and an error only
+// when the JSON itself fails to decode.
+func ParsePayload(eventType string, raw json.RawMessage) (any, error) {
+	var v any
+	switch eventType {
+	case "PushEvent":
+		v = &PushPayload{}
+	case "IssuesEvent":
+		v = &IssuesPayload{}
+	case "IssueCommentEvent":
+		v = &IssueCommentPayload{}
+	case "PullRequestEvent":
+		v = &PullRequestPayload{}
+	case "PullRequestReviewEvent":
+		v = &PullRequestReviewPayload{}
+	case "PullRequestReviewCommentEvent":
+		v = &PullRequestReviewCommentPayload{}
+	case "CreateEvent":
+		v = &CreatePayload{}
+	case "DeleteEvent":
+		v = &DeletePayload{}
+	case "CommitCommentEvent":
+		v = &CommitCommentPayload{}
+	case "GollumEvent":
+		v = &GollumPayload{}
+	case "MemberEvent":
+		v = &MemberPayload{}
+	case "PublicEvent":
+		v = &PublicPayload{}
+	case "ReleaseEvent":
+		v = &ReleasePayload{}
+	default:
+		return nil, nil
+	}
+
+	if len(raw) == 0 {
+		return v, nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return nil, fmt.Errorf("decode %s payload: %w", eventType, err)
+	}
+	return v, nil
+}
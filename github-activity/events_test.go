@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParsePayload(t *testing.T) {
+	raw := json.RawMessage(`{"action":"opened","pull_request":{"merged":false}}`)
+	v, err := ParsePayload("PullRequestEvent", raw)
+	if err != nil {
+		t.Fatalf("ParsePayload: %v", err)
+	}
+	p, ok := v.(*PullRequestPayload)
+	if !ok {
+		t.Fatalf("got %T, want *PullRequestPayload", v)
+	}
+	if p.Action != "opened" {
+		t.Errorf("Action = %q, want %q", p.Action, "opened")
+	}
+}
+
+func TestParsePayloadUnknownEventType(t *testing.T) {
+	v, err := ParsePayload("SomeFutureEvent", json.RawMessage(`{}`))
+	if err != nil {
+		t.Fatalf("ParsePayload: %v", err)
+	}
+	if v != nil {
+		t.Errorf("got %v, want nil for an unmodeled event type", v)
+	}
+}
+
+func TestParsePayloadEmptyRaw(t *testing.T) {
+	v, err := ParsePayload("PublicEvent", nil)
+	if err != nil {
+		t.Fatalf("ParsePayload: %v", err)
+	}
+	if _, ok := v.(*PublicPayload); !ok {
+		t.Fatalf("got %T, want *PublicPayload", v)
+	}
+}
+
+func TestParsePayloadInvalidJSON(t *testing.T) {
+	_, err := ParsePayload("IssuesEvent", json.RawMessage(`not json`))
+	if err == nil {
+		t.Fatal("expected an error decoding invalid JSON, got nil")
+	}
+}
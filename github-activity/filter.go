@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// eventFilter narrows down a fetched event list before it's printed or
+// grouped. Zero values mean "no filter on this dimension".
+type eventFilter struct {
+	Since time.Time
+	Until time.Time
+	Types map[string]bool
+	Repo  string
+}
+
+// newEventFilter builds an eventFilter from the --since/--until/--type/--repo
+// flag values, parsing each one.
+func newEventFilter(since, until, types, repo string) (eventFilter, error) {
+	var f eventFilter
+
+	if since != "" {
+		t, err := parseTimeBound(since)
+		if err != nil {
+			return eventFilter{}, fmt.Errorf("--since: %w", err)
+		}
+		f.Since = t
+	}
+	if until != "" {
+		t, err := parseTimeBound(until)
+		if err != nil {
+			return eventFilter{}, fmt.Errorf("--until: %w", err)
+		}
+		f.Until = t
+	}
+	f.Types = eventTypeSet(types)
+	f.Repo = repo
+
+	return f, nil
+}
+
+// parseTimeBound accepts either a relative duration shorthand ("7d", "24h",
+// "30m") measured back from now, or an absolute date/time in RFC3339 or
+// "2006-01-02".
+func parseTimeBound(value string) (time.Time, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid relative time %q", value)
+		}
+		return time.Now().AddDate(0, 0, -days), nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-d), nil
+	}
+
+	layouts := []string{time.RFC3339, "2006-01-02"}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("could not parse %q as a duration or date", value)
+}
+
+// match reports whether e passes every dimension of the filter.
+func (f eventFilter) match(e Event) bool {
+	if !f.Since.IsZero() && e.CreatedAt.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && e.CreatedAt.After(f.Until) {
+		return false
+	}
+	if len(f.Types) > 0 && !f.Types[e.Type] {
+		return false
+	}
+	if f.Repo != "" && e.Repo.Name != f.Repo {
+		return false
+	}
+	return true
+}
+
+// filterEvents returns the events in events that pass f, preserving order.
+func filterEvents(events []Event, f eventFilter) []Event {
+	out := make([]Event, 0, len(events))
+	for _, e := range events {
+		if f.match(e) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimeBoundRelative(t *testing.T) {
+	cases := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"7d", 7 * 24 * time.Hour},
+		{"24h", 24 * time.Hour},
+		{"30m", 30 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.value, func(t *testing.T) {
+			before := time.Now()
+			got, err := parseTimeBound(tc.value)
+			if err != nil {
+				t.Fatalf("parseTimeBound(%q): %v", tc.value, err)
+			}
+			after := time.Now()
+
+			wantMin := before.Add(-tc.want)
+			wantMax := after.Add(-tc.want)
+			if got.Before(wantMin.Add(-time.Second)) || got.After(wantMax.Add(time.Second)) {
+				t.Errorf("parseTimeBound(%q) = %v, want ~%v", tc.value, got, wantMin)
+			}
+		})
+	}
+}
+
+func TestParseTimeBoundAbsolute(t *testing.T) {
+	got, err := parseTimeBound("2024-01-02")
+	if err != nil {
+		t.Fatalf("parseTimeBound: %v", err)
+	}
+	want := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("parseTimeBound(\"2024-01-02\") = %v, want %v", got, want)
+	}
+}
+
+func TestParseTimeBoundRFC3339(t *testing.T) {
+	got, err := parseTimeBound("2024-01-02T03:04:05Z")
+	if err != nil {
+		t.Fatalf("parseTimeBound: %v", err)
+	}
+	want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("parseTimeBound(...) = %v, want %v", got, want)
+	}
+}
+
+func TestParseTimeBoundInvalid(t *testing.T) {
+	if _, err := parseTimeBound("whenever"); err == nil {
+		t.Fatal("expected an error for an unparseable value, got nil")
+	}
+	if _, err := parseTimeBound("3xd"); err == nil {
+		t.Fatal("expected an error for an invalid relative day count, got nil")
+	}
+}
+
+func TestFilterEventsMatchesAllDimensions(t *testing.T) {
+	now := time.Now()
+	events := []Event{
+		{Type: "PushEvent", Repo: Repo{Name: "owner/repo"}, CreatedAt: now},
+		{Type: "IssuesEvent", Repo: Repo{Name: "owner/repo"}, CreatedAt: now.Add(-48 * time.Hour)},
+		{Type: "PushEvent", Repo: Repo{Name: "other/repo"}, CreatedAt: now},
+	}
+
+	f := eventFilter{
+		Since: now.Add(-time.Hour),
+		Types: eventTypeSet("PushEvent"),
+		Repo:  "owner/repo",
+	}
+
+	got := filterEvents(events, f)
+	if len(got) != 1 {
+		t.Fatalf("filterEvents() returned %d events, want 1: %+v", len(got), got)
+	}
+	if got[0].Repo.Name != "owner/repo" || got[0].Type != "PushEvent" {
+		t.Errorf("unexpected surviving event: %+v", got[0])
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// gerritMagicPrefix is prepended to every Gerrit REST API JSON response to
+// guard against XSSI; clients are expected to strip it before decoding.
+// See https://gerrit-review.googlesource.com/Documentation/rest-api.html#output.
+var gerritMagicPrefix = []byte(")]}'")
+
+// gerritActivity fetches a user's changes from a Gerrit instance's
+// changes query endpoint: GET /changes/?q=owner:<user>. This is the
+// anonymous namespace (no "/a/" prefix), matching the unauthenticated
+// default every other backend here supports; Gerrit servers that require
+// auth for anonymous reads are out of scope, same as GitHub/GitLab/Gitea
+// instances that don't allow public access.
+type gerritActivity struct {
+	host string
+}
+
+// gerritTimestampLayout is the format Gerrit uses for timestamp fields:
+// UTC, no "T" separator, nanosecond precision.
+const gerritTimestampLayout = "2006-01-02 15:04:05.000000000"
+
+type gerritChange struct {
+	ID      string `json:"id"` // "<project>~<branch>~<Change-Id>", unique across the whole server.
+	Project string `json:"project"`
+	Status  string `json:"status"` // "NEW", "MERGED", "ABANDONED".
+	Updated string `json:"updated"`
+}
+
+func (gr *gerritActivity) Fetch(username string) ([]Event, error) {
+	q := fmt.Sprintf("owner:%s", username)
+	endpoint := fmt.Sprintf("https://%s/changes/?q=%s", gr.host, url.QueryEscape(q))
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response %w", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("gerrit api error %s resp: %s", res.Status, string(body))
+	}
+
+	body = bytes.TrimPrefix(body, gerritMagicPrefix)
+
+	var changes []gerritChange
+	if err := json.Unmarshal(body, &changes); err != nil {
+		return nil, fmt.Errorf("invalid json %w", err)
+	}
+
+	events := make([]Event, 0, len(changes))
+	for _, c := range changes {
+		p := PullRequestPayload{Action: gerritStatusToAction(c.Status)}
+		p.PullRequest.Merged = c.Status == "MERGED"
+		payload, _ := json.Marshal(p)
+		updated, _ := time.Parse(gerritTimestampLayout, c.Updated)
+		events = append(events, Event{
+			ID:        c.ID,
+			Type:      "PullRequestEvent",
+			Repo:      Repo{Name: c.Project},
+			Payload:   payload,
+			CreatedAt: updated,
+		})
+	}
+	return events, nil
+}
+
+// gerritStatusToAction maps a Gerrit change status onto the action
+// vocabulary GitHub uses for pull requests, so format.go's existing
+// formatPullRequest renders it without a Gerrit-specific case.
+func gerritStatusToAction(status string) string {
+	switch status {
+	case "MERGED", "ABANDONED":
+		return "closed"
+	default:
+		return "opened"
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// giteaActivity fetches events from a Gitea (or Forgejo) instance's user
+// activity feed: GET /api/v1/users/:name/activities/feeds. See
+// https://docs.gitea.com/api/1.20/#tag/user/operation/userGetActivityFeeds.
+type giteaActivity struct {
+	host string
+}
+
+type giteaActivityItem struct {
+	ID          int64  `json:"id"`
+	OpType      string `json:"op_type"` // "commit_repo", "create_issue", "merge_pull_request", ...
+	CreatedUnix int64  `json:"created_unix"`
+	Repo        struct {
+		FullName string `json:"full_name"`
+	} `json:"repo"`
+}
+
+func (gt *giteaActivity) Fetch(username string) ([]Event, error) {
+	url := fmt.Sprintf("https://%s/api/v1/users/%s/activities/feeds", gt.host, username)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response %w", err)
+	}
+
+	switch res.StatusCode {
+	case 200:
+		//ok
+	case 404:
+		return nil, fmt.Errorf("user %s not found", username)
+	default:
+		return nil, fmt.Errorf("gitea api error %s resp: %s", res.Status, string(body))
+	}
+
+	var raw []giteaActivityItem
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("invalid json %w", err)
+	}
+
+	events := make([]Event, 0, len(raw))
+	for _, item := range raw {
+		events = append(events, giteaToEvent(item))
+	}
+	return events, nil
+}
+
+// giteaToEvent maps a single Gitea op_type onto the GitHub event vocabulary
+// that format.go already knows how to render.
+func giteaToEvent(item giteaActivityItem) Event {
+	id := strconv.FormatInt(item.ID, 10)
+	repo := Repo{Name: item.Repo.FullName}
+	createdAt := time.Unix(item.CreatedUnix, 0)
+
+	switch item.OpType {
+	case "commit_repo":
+		payload, _ := json.Marshal(PushPayload{})
+		return Event{ID: id, Type: "PushEvent", Repo: repo, Payload: payload, CreatedAt: createdAt}
+	case "create_issue":
+		payload, _ := json.Marshal(IssuesPayload{Action: "opened"})
+		return Event{ID: id, Type: "IssuesEvent", Repo: repo, Payload: payload, CreatedAt: createdAt}
+	case "close_issue":
+		payload, _ := json.Marshal(IssuesPayload{Action: "closed"})
+		return Event{ID: id, Type: "IssuesEvent", Repo: repo, Payload: payload, CreatedAt: createdAt}
+	case "reopen_issue":
+		payload, _ := json.Marshal(IssuesPayload{Action: "reopened"})
+		return Event{ID: id, Type: "IssuesEvent", Repo: repo, Payload: payload, CreatedAt: createdAt}
+	case "comment_issue", "comment_pull":
+		payload, _ := json.Marshal(IssueCommentPayload{Action: "created"})
+		return Event{ID: id, Type: "IssueCommentEvent", Repo: repo, Payload: payload, CreatedAt: createdAt}
+	case "create_pull_request":
+		payload, _ := json.Marshal(PullRequestPayload{Action: "opened"})
+		return Event{ID: id, Type: "PullRequestEvent", Repo: repo, Payload: payload, CreatedAt: createdAt}
+	case "close_pull_request":
+		payload, _ := json.Marshal(PullRequestPayload{Action: "closed"})
+		return Event{ID: id, Type: "PullRequestEvent", Repo: repo, Payload: payload, CreatedAt: createdAt}
+	case "merge_pull_request":
+		p := PullRequestPayload{Action: "closed"}
+		p.PullRequest.Merged = true
+		payload, _ := json.Marshal(p)
+		return Event{ID: id, Type: "PullRequestEvent", Repo: repo, Payload: payload, CreatedAt: createdAt}
+	case "create_repo":
+		payload, _ := json.Marshal(CreatePayload{RefType: "repository"})
+		return Event{ID: id, Type: "CreateEvent", Repo: repo, Payload: payload, CreatedAt: createdAt}
+	case "star_repo":
+		return Event{ID: id, Type: "WatchEvent", Repo: repo, CreatedAt: createdAt}
+	default:
+		return Event{ID: id, Type: item.OpType, Repo: repo, CreatedAt: createdAt}
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGiteaToEvent(t *testing.T) {
+	cases := []struct {
+		opType     string
+		wantType   string
+		wantMerged bool
+	}{
+		{"commit_repo", "PushEvent", false},
+		{"create_issue", "IssuesEvent", false},
+		{"create_pull_request", "PullRequestEvent", false},
+		{"merge_pull_request", "PullRequestEvent", true},
+		{"star_repo", "WatchEvent", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.opType, func(t *testing.T) {
+			item := giteaActivityItem{ID: 7, OpType: tc.opType}
+			item.Repo.FullName = "owner/repo"
+
+			got := giteaToEvent(item)
+			if got.Type != tc.wantType {
+				t.Errorf("Type = %q, want %q", got.Type, tc.wantType)
+			}
+			if got.ID != "7" {
+				t.Errorf("ID = %q, want %q", got.ID, "7")
+			}
+			if got.Repo.Name != "owner/repo" {
+				t.Errorf("Repo.Name = %q, want %q", got.Repo.Name, "owner/repo")
+			}
+
+			if tc.wantType != "PullRequestEvent" {
+				return
+			}
+			var payload PullRequestPayload
+			if err := json.Unmarshal(got.Payload, &payload); err != nil {
+				t.Fatalf("unmarshal payload: %v", err)
+			}
+			if payload.PullRequest.Merged != tc.wantMerged {
+				t.Errorf("Merged = %v, want %v", payload.PullRequest.Merged, tc.wantMerged)
+			}
+		})
+	}
+}
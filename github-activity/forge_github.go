@@ -0,0 +1,180 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// githubMaxPages/githubMaxEvents mirror the events endpoint's own limits:
+// GitHub never returns more than 300 events or 10 pages of results no
+// matter how far you paginate.
+const (
+	githubMaxPages  = 10
+	githubMaxEvents = 300
+)
+
+// githubActivity fetches events from GitHub's "list public events for a
+// user" API (https://docs.github.com/en/rest/activity/events). host lets
+// GitHub Enterprise users point at their own API root instead of
+// api.github.com.
+type githubActivity struct {
+	host  string
+	token string // From githubToken(); empty means unauthenticated requests.
+	wait  bool   // If true, block until the rate limit resets instead of erroring.
+	max   int    // Extra cap on events returned; 0 means just githubMaxEvents.
+}
+
+func (g *githubActivity) Fetch(username string) ([]Event, error) {
+	firstURL := fmt.Sprintf("https://%s/users/%s/events", g.host, username)
+
+	cached, err := loadCacheEntry(firstURL)
+	if err != nil {
+		return nil, fmt.Errorf("read cache: %w", err)
+	}
+	if cached != nil && cached.PollInterval > 0 {
+		if time.Since(cached.FetchedAt) < time.Duration(cached.PollInterval)*time.Second {
+			events, err := decodeEvents(cached.Body)
+			if err != nil {
+				return nil, err
+			}
+			return g.trim(events), nil
+		}
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var all []Event
+	url := firstURL
+	for page := 0; page < githubMaxPages && url != ""; page++ {
+		req, err := g.newRequest(url)
+		if err != nil {
+			return nil, err
+		}
+		if page == 0 && cached != nil {
+			if cached.ETag != "" {
+				req.Header.Set("If-None-Match", cached.ETag)
+			}
+			if cached.LastModified != "" {
+				req.Header.Set("If-Modified-Since", cached.LastModified)
+			}
+		}
+
+		res, body, err := g.do(client, req, username)
+		if err != nil {
+			return nil, err
+		}
+
+		if page == 0 && res.StatusCode == http.StatusNotModified {
+			if cached == nil {
+				return nil, fmt.Errorf("github api returned 304 with no cached body")
+			}
+			events, err := decodeEvents(cached.Body)
+			if err != nil {
+				return nil, err
+			}
+			return g.trim(events), nil
+		}
+
+		events, err := decodeEvents(body)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, events...)
+
+		if page == 0 {
+			pollInterval, _ := strconv.Atoi(res.Header.Get("X-Poll-Interval"))
+			if err := saveCacheEntry(firstURL, cacheEntry{
+				ETag:         res.Header.Get("ETag"),
+				LastModified: res.Header.Get("Last-Modified"),
+				PollInterval: pollInterval,
+				FetchedAt:    time.Now(),
+				Body:         body,
+			}); err != nil {
+				// Caching is an optimization; don't fail the run over it.
+				fmt.Fprintln(os.Stderr, "warning: failed to write cache:", err)
+			}
+		}
+
+		if len(all) >= githubMaxEvents || (g.max > 0 && len(all) >= g.max) {
+			break
+		}
+
+		url = nextPageURL(res.Header.Get("Link"))
+	}
+
+	return g.trim(all), nil
+}
+
+// trim caps events at githubMaxEvents and, if set, g.max — applied on every
+// return path (live fetch, 304, and the poll-interval cache shortcut) so
+// --max has the same effect regardless of which one serves the request.
+func (g *githubActivity) trim(events []Event) []Event {
+	if len(events) > githubMaxEvents {
+		events = events[:githubMaxEvents]
+	}
+	if g.max > 0 && len(events) > g.max {
+		events = events[:g.max]
+	}
+	return events
+}
+
+func (g *githubActivity) newRequest(url string) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "github-activity-cli")
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if g.token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.token)
+	}
+	return req, nil
+}
+
+// do issues req and reads its body, retrying once after sleeping until the
+// rate limit resets when g.wait is set and we got rate limited.
+func (g *githubActivity) do(client *http.Client, req *http.Request, username string) (*http.Response, []byte, error) {
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request failed %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response %w", err)
+	}
+
+	switch res.StatusCode {
+	case 200, http.StatusNotModified:
+		return res, body, nil
+	case 404:
+		return nil, nil, fmt.Errorf("user %s not found", username)
+	case 403:
+		if !rateLimited(res) {
+			return nil, nil, fmt.Errorf("forbidden 403, response %s", string(body))
+		}
+		if !g.wait {
+			return nil, nil, rateLimitErr(res)
+		}
+		if sleep := time.Until(rateLimitReset(res)); sleep > 0 {
+			time.Sleep(sleep)
+		}
+		return g.do(client, req.Clone(req.Context()), username)
+	default:
+		return nil, nil, fmt.Errorf("github api error %s resp: %s", res.Status, string(body))
+	}
+}
+
+func decodeEvents(body json.RawMessage) ([]Event, error) {
+	var events []Event
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, fmt.Errorf("invalid json %w", err)
+	}
+	return events, nil
+}
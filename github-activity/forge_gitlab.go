@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// gitlabActivity fetches events from GitLab's user events API
+// (GET /api/v4/users/:id/events) and maps them onto the same Event shape
+// the GitHub backend produces, reusing the existing payload structs and
+// formatters wherever GitLab's action vocabulary lines up with GitHub's.
+type gitlabActivity struct {
+	host string
+}
+
+// gitlabEvent is the subset of GitLab's event resource we care about. See
+// https://docs.gitlab.com/ee/api/events.html.
+type gitlabEvent struct {
+	ID         int       `json:"id"`
+	ProjectID  int       `json:"project_id"`
+	ActionName string    `json:"action_name"` // "pushed to", "opened", "closed", "accepted", "commented on", ...
+	TargetType string    `json:"target_type"` // "Issue", "MergeRequest", or "".
+	CreatedAt  time.Time `json:"created_at"`
+	PushData   *struct {
+		CommitCount int `json:"commit_count"`
+	} `json:"push_data"`
+}
+
+func (gl *gitlabActivity) Fetch(username string) ([]Event, error) {
+	id, err := gl.lookupUserID(username)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("https://%s/api/v4/users/%d/events", gl.host, id)
+	var raw []gitlabEvent
+	if err := gl.getJSON(url, &raw); err != nil {
+		return nil, err
+	}
+
+	events := make([]Event, 0, len(raw))
+	for _, re := range raw {
+		events = append(events, gitlabToEvent(re))
+	}
+	return events, nil
+}
+
+// gitlabToEvent maps a single GitLab event resource onto the Event shape,
+// reusing the existing GitHub payload structs wherever GitLab's action
+// vocabulary lines up with GitHub's.
+func gitlabToEvent(re gitlabEvent) Event {
+	// GitLab's events API doesn't return the project's path, only its
+	// numeric id; resolving it would cost one extra request per distinct
+	// project, so we surface the id instead.
+	repo := Repo{Name: fmt.Sprintf("project-%d", re.ProjectID)}
+	id := strconv.Itoa(re.ID)
+
+	switch {
+	case re.PushData != nil:
+		commits := make([]struct {
+			SHA string `json:"sha"`
+		}, re.PushData.CommitCount)
+		payload, _ := json.Marshal(PushPayload{Commits: commits})
+		return Event{ID: id, Type: "PushEvent", Repo: repo, Payload: payload, CreatedAt: re.CreatedAt}
+
+	case re.TargetType == "Issue":
+		payload, _ := json.Marshal(IssuesPayload{Action: gitlabActionToGitHub(re.ActionName)})
+		return Event{ID: id, Type: "IssuesEvent", Repo: repo, Payload: payload, CreatedAt: re.CreatedAt}
+
+	case re.TargetType == "MergeRequest":
+		p := PullRequestPayload{Action: gitlabActionToGitHub(re.ActionName)}
+		p.PullRequest.Merged = re.ActionName == "accepted"
+		payload, _ := json.Marshal(p)
+		return Event{ID: id, Type: "PullRequestEvent", Repo: repo, Payload: payload, CreatedAt: re.CreatedAt}
+
+	default:
+		return Event{ID: id, Type: re.ActionName, Repo: repo, CreatedAt: re.CreatedAt}
+	}
+}
+
+// gitlabActionToGitHub maps GitLab's free-form action_name onto the action
+// vocabulary GitHub uses ("opened", "closed", ...) so format.go's existing
+// formatters read the same regardless of which forge produced the event.
+func gitlabActionToGitHub(action string) string {
+	switch action {
+	case "accepted":
+		return "closed" // Merged; callers check action_name == "accepted" for the Merged flag.
+	case "opened", "closed", "reopened":
+		return action
+	default:
+		return action
+	}
+}
+
+func (gl *gitlabActivity) lookupUserID(username string) (int, error) {
+	url := fmt.Sprintf("https://%s/api/v4/users?username=%s", gl.host, username)
+	var users []struct {
+		ID int `json:"id"`
+	}
+	if err := gl.getJSON(url, &users); err != nil {
+		return 0, err
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("gitlab user %s not found", username)
+	}
+	return users[0].ID, nil
+}
+
+func (gl *gitlabActivity) getJSON(url string, out any) error {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response %w", err)
+	}
+	if res.StatusCode != 200 {
+		return fmt.Errorf("gitlab api error %s resp: %s", res.Status, string(body))
+	}
+
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestGitlabToEvent(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		event      gitlabEvent
+		wantType   string
+		wantMerged bool
+	}{
+		{
+			name: "accepted merge request is reported as merged",
+			event: gitlabEvent{
+				ID: 1, ProjectID: 10, ActionName: "accepted", TargetType: "MergeRequest", CreatedAt: createdAt,
+			},
+			wantType:   "PullRequestEvent",
+			wantMerged: true,
+		},
+		{
+			name: "closed merge request without accepting is not merged",
+			event: gitlabEvent{
+				ID: 2, ProjectID: 10, ActionName: "closed", TargetType: "MergeRequest", CreatedAt: createdAt,
+			},
+			wantType:   "PullRequestEvent",
+			wantMerged: false,
+		},
+		{
+			name: "opened issue",
+			event: gitlabEvent{
+				ID: 3, ProjectID: 10, ActionName: "opened", TargetType: "Issue", CreatedAt: createdAt,
+			},
+			wantType: "IssuesEvent",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := gitlabToEvent(tc.event)
+			if got.Type != tc.wantType {
+				t.Errorf("Type = %q, want %q", got.Type, tc.wantType)
+			}
+			if got.Repo.Name != "project-10" {
+				t.Errorf("Repo.Name = %q, want %q", got.Repo.Name, "project-10")
+			}
+			if !got.CreatedAt.Equal(createdAt) {
+				t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, createdAt)
+			}
+
+			if tc.wantType != "PullRequestEvent" {
+				return
+			}
+			var payload PullRequestPayload
+			if err := json.Unmarshal(got.Payload, &payload); err != nil {
+				t.Fatalf("unmarshal payload: %v", err)
+			}
+			if payload.PullRequest.Merged != tc.wantMerged {
+				t.Errorf("Merged = %v, want %v", payload.PullRequest.Merged, tc.wantMerged)
+			}
+		})
+	}
+}
+
+func TestGitlabToEventSetsID(t *testing.T) {
+	got := gitlabToEvent(gitlabEvent{ID: 42, ProjectID: 1, ActionName: "pushed to"})
+	if got.ID != "42" {
+		t.Errorf("ID = %q, want %q", got.ID, "42")
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import "fmt"
+
+// formatEvent converts an event into a human readable line. It decodes the
+// payload via ParsePayload and switches on the concrete type, so supporting
+// a new event type is one new struct in events.go plus one new case/helper
+// here.
+func formatEvent(e Event) string {
+	repo := e.Repo.Name
+
+	payload, err := ParsePayload(e.Type, e.Payload)
+	if err != nil {
+		// Payload didn't decode as expected; fall through to the generic
+		// rendering below rather than failing the whole run.
+		payload = nil
+	}
+
+	switch p := payload.(type) {
+	case *PushPayload:
+		return formatPush(p, repo)
+	case *IssuesPayload:
+		action := p.Action
+		if action == "" {
+			action = "updated"
+		}
+		return fmt.Sprintf("%s an issue in %s", verbCap(action), repo)
+	case *IssueCommentPayload:
+		return formatIssueComment(p, repo)
+	case *PullRequestPayload:
+		return formatPullRequest(p, repo)
+	case *PullRequestReviewPayload:
+		return formatPullRequestReview(p, repo)
+	case *PullRequestReviewCommentPayload:
+		return fmt.Sprintf("Commented on a pull request diff in %s", repo)
+	case *CreatePayload:
+		return formatCreate(p, repo)
+	case *DeletePayload:
+		return formatDelete(p, repo)
+	case *CommitCommentPayload:
+		return fmt.Sprintf("Commented on a commit in %s", repo)
+	case *GollumPayload:
+		return formatGollum(p, repo)
+	case *MemberPayload:
+		return formatMember(p, repo)
+	case *PublicPayload:
+		return fmt.Sprintf("Made %s public", repo)
+	case *ReleasePayload:
+		return formatRelease(p, repo)
+	}
+
+	// Event types with no payload we care about, or that ParsePayload
+	// doesn't model at all.
+	switch e.Type {
+	case "WatchEvent":
+		// GitHub uses WatchEvent with action "started" for stars.
+		return fmt.Sprintf("Starred %s", repo)
+	case "ForkEvent":
+		return fmt.Sprintf("Forked %s", repo)
+	default:
+		if repo != "" {
+			return fmt.Sprintf("%s in %s", e.Type, repo)
+		}
+		return e.Type
+	}
+}
+
+func formatPush(p *PushPayload, repo string) string {
+	n := len(p.Commits)
+	if n == 1 {
+		return fmt.Sprintf("Pushed 1 commit to %s", repo)
+	}
+	return fmt.Sprintf("Pushed %d commits to %s", n, repo)
+}
+
+func formatIssueComment(p *IssueCommentPayload, repo string) string {
+	if p.Action == "deleted" {
+		return fmt.Sprintf("Deleted a comment on an issue in %s", repo)
+	}
+	return fmt.Sprintf("Commented on an issue in %s", repo)
+}
+
+func formatPullRequest(p *PullRequestPayload, repo string) string {
+	if p.Action == "closed" && p.PullRequest.Merged {
+		return fmt.Sprintf("Merged a pull request in %s", repo)
+	}
+	if p.Action != "" {
+		return fmt.Sprintf("%s a pull request in %s", verbCap(p.Action), repo)
+	}
+	return fmt.Sprintf("Updated a pull request in %s", repo)
+}
+
+func formatPullRequestReview(p *PullRequestReviewPayload, repo string) string {
+	switch p.Review.State {
+	case "approved":
+		return fmt.Sprintf("Approved a pull request in %s", repo)
+	case "changes_requested":
+		return fmt.Sprintf("Requested changes on a pull request in %s", repo)
+	default:
+		return fmt.Sprintf("Reviewed a pull request in %s", repo)
+	}
+}
+
+func formatCreate(p *CreatePayload, repo string) string {
+	if p.RefType == "repository" {
+		return fmt.Sprintf("Created repository %s", repo)
+	}
+	if p.Ref != "" {
+		return fmt.Sprintf("Created %s %q in %s", p.RefType, p.Ref, repo)
+	}
+	return fmt.Sprintf("Created %s in %s", p.RefType, repo)
+}
+
+func formatDelete(p *DeletePayload, repo string) string {
+	return fmt.Sprintf("Deleted %s %q in %s", p.RefType, p.Ref, repo)
+}
+
+func formatGollum(p *GollumPayload, repo string) string {
+	n := len(p.Pages)
+	if n == 1 {
+		return fmt.Sprintf("Updated 1 wiki page in %s", repo)
+	}
+	return fmt.Sprintf("Updated %d wiki pages in %s", n, repo)
+}
+
+func formatMember(p *MemberPayload, repo string) string {
+	if p.Member.Login == "" {
+		return fmt.Sprintf("%s a member in %s", verbCap(p.Action), repo)
+	}
+	return fmt.Sprintf("%s %s as a collaborator in %s", verbCap(p.Action), p.Member.Login, repo)
+}
+
+func formatRelease(p *ReleasePayload, repo string) string {
+	if p.Release.TagName != "" {
+		return fmt.Sprintf("Released %s in %s", p.Release.TagName, repo)
+	}
+	return fmt.Sprintf("Released in %s", repo)
+}
+
+// verbCap capitalizes the first letter of a string ("opened" -> "Opened").
+func verbCap(action string) string {
+	if action == "" {
+		return ""
+	}
+
+	b := []byte(action)
+	if b[0] >= 'a' && b[0] <= 'z' {
+		b[0] = b[0] - ('a' - 'A')
+	}
+
+	return string(b)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// outputEvent is what gets serialized for --output=json/ndjson/yaml: the
+// normalized event plus its decoded payload, so downstream tools (jq, a
+// contribution-log repo) don't have to re-parse the raw payload themselves.
+type outputEvent struct {
+	ID        string     `json:"id,omitempty"`
+	Type      string     `json:"type"`
+	Repo      string     `json:"repo"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Payload   any        `json:"payload,omitempty"`
+}
+
+func toOutputEvents(events []Event) []outputEvent {
+	out := make([]outputEvent, 0, len(events))
+	for _, e := range events {
+		payload, _ := ParsePayload(e.Type, e.Payload)
+		oe := outputEvent{
+			ID:      e.ID,
+			Type:    e.Type,
+			Repo:    e.Repo.Name,
+			Payload: payload,
+		}
+		if !e.CreatedAt.IsZero() {
+			createdAt := e.CreatedAt
+			oe.CreatedAt = &createdAt
+		}
+		out = append(out, oe)
+	}
+	return out
+}
+
+// printEvents renders events in the named structured format. Callers handle
+// "text" themselves; this covers the rest.
+func printEvents(events []Event, format string) error {
+	switch format {
+	case "json":
+		return printJSON(events)
+	case "ndjson":
+		return printNDJSON(events)
+	case "yaml":
+		return printYAML(events)
+	case "md":
+		return printMarkdown(events)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+func printJSON(events []Event) error {
+	data, err := json.MarshalIndent(toOutputEvents(events), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func printNDJSON(events []Event) error {
+	for _, oe := range toOutputEvents(events) {
+		data, err := json.Marshal(oe)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	}
+	return nil
+}
+
+// printMarkdown emits a report grouped by repo, suitable for pasting into a
+// standup or a personal site's "I'm working on" page.
+func printMarkdown(events []Event) error {
+	for _, g := range groupEvents(events, "repo") {
+		fmt.Printf("## %s\n\n", repoMarkdownLink(g.Key))
+		for _, e := range g.Events {
+			if line := formatEvent(e); line != "" {
+				fmt.Printf("- %s\n", line)
+			}
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+// repoMarkdownLink links repo to its GitHub page when it looks like an
+// "owner/name" slug; other forges' repo identifiers (e.g. GitLab's
+// "project-123") are printed unlinked since we can't build a working URL
+// from them.
+func repoMarkdownLink(repo string) string {
+	if !strings.Contains(repo, "/") {
+		return repo
+	}
+	return fmt.Sprintf("[%s](https://github.com/%s)", repo, repo)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToOutputEventsOmitsZeroCreatedAt(t *testing.T) {
+	out := toOutputEvents([]Event{{Type: "WatchEvent", Repo: Repo{Name: "owner/repo"}}})
+	if out[0].CreatedAt != nil {
+		t.Fatalf("CreatedAt = %v, want nil", out[0].CreatedAt)
+	}
+
+	data, err := json.Marshal(out[0])
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if got := string(data); strings.Contains(got, "created_at") {
+		t.Errorf("json output %s contains created_at for a zero-value timestamp", got)
+	}
+}
+
+func TestToOutputEventsKeepsNonZeroCreatedAt(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	out := toOutputEvents([]Event{{Type: "WatchEvent", Repo: Repo{Name: "owner/repo"}, CreatedAt: createdAt}})
+	if out[0].CreatedAt == nil || !out[0].CreatedAt.Equal(createdAt) {
+		t.Fatalf("CreatedAt = %v, want %v", out[0].CreatedAt, createdAt)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateLimited reports whether res indicates GitHub's rate limit has been
+// exhausted (a 403 with X-RateLimit-Remaining: 0; GitHub uses the same
+// status code for other kinds of "forbidden", which is why callers check
+// this rather than just the status).
+func rateLimited(res *http.Response) bool {
+	return res.Header.Get("X-RateLimit-Remaining") == "0"
+}
+
+// rateLimitReset returns when the current rate limit window resets, per the
+// X-RateLimit-Reset header (a Unix timestamp).
+func rateLimitReset(res *http.Response) time.Time {
+	sec, _ := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64)
+	return time.Unix(sec, 0)
+}
+
+// rateLimitErr builds the error surfaced when the rate limit is hit and
+// --wait wasn't passed.
+func rateLimitErr(res *http.Response) error {
+	reset := rateLimitReset(res)
+	return fmt.Errorf("rate limited, resets at %s (try again then, or pass --wait to block until then)", reset.Format(time.RFC3339))
+}
+
+// nextPageURL extracts the "next" target from an RFC 5988 Link header
+// (e.g. `<https://api.github.com/...&page=2>; rel="next"`), or "" if there
+// isn't one.
+func nextPageURL(link string) string {
+	if link == "" {
+		return ""
+	}
+	for _, part := range strings.Split(link, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 {
+			continue
+		}
+		target := strings.Trim(strings.TrimSpace(segments[0]), "<>")
+		for _, seg := range segments[1:] {
+			if strings.TrimSpace(seg) == `rel="next"` {
+				return target
+			}
+		}
+	}
+	return ""
+}
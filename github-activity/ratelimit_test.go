@@ -0,0 +1,80 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimited(t *testing.T) {
+	cases := []struct {
+		name    string
+		headers map[string]string
+		want    bool
+	}{
+		{"remaining zero", map[string]string{"X-RateLimit-Remaining": "0"}, true},
+		{"remaining positive", map[string]string{"X-RateLimit-Remaining": "10"}, false},
+		{"header absent", nil, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{}}
+			for k, v := range tc.headers {
+				res.Header.Set(k, v)
+			}
+			if got := rateLimited(res); got != tc.want {
+				t.Errorf("rateLimited() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitResetMalformedHeader(t *testing.T) {
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("X-RateLimit-Reset", "not-a-number")
+
+	// ParseInt fails silently (the error is discarded) and sec stays 0, so a
+	// malformed header resolves to the Unix epoch rather than a parse error.
+	want := time.Unix(0, 0)
+	if got := rateLimitReset(res); !got.Equal(want) {
+		t.Errorf("rateLimitReset() = %v, want %v", got, want)
+	}
+}
+
+func TestNextPageURL(t *testing.T) {
+	cases := []struct {
+		name string
+		link string
+		want string
+	}{
+		{
+			name: "has next and last",
+			link: `<https://api.github.com/x?page=2>; rel="next", <https://api.github.com/x?page=5>; rel="last"`,
+			want: "https://api.github.com/x?page=2",
+		},
+		{
+			name: "only last, no next (final page)",
+			link: `<https://api.github.com/x?page=5>; rel="last"`,
+			want: "",
+		},
+		{
+			name: "empty header",
+			link: "",
+			want: "",
+		},
+		{
+			name: "malformed segment without rel",
+			link: `<https://api.github.com/x?page=2>`,
+			want: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := nextPageURL(tc.link); got != tc.want {
+				t.Errorf("nextPageURL(%q) = %q, want %q", tc.link, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// eventGroup is a named bucket of events, produced by groupEvents.
+type eventGroup struct {
+	Key    string
+	Events []Event
+}
+
+// groupEvents buckets events by "repo", "type", or "day" (the event's
+// CreatedAt formatted as 2006-01-02), preserving the order each key was
+// first seen in events.
+func groupEvents(events []Event, groupBy string) []eventGroup {
+	index := make(map[string]int)
+	var groups []eventGroup
+
+	for _, e := range events {
+		var key string
+		switch groupBy {
+		case "repo":
+			key = e.Repo.Name
+		case "type":
+			key = e.Type
+		case "day":
+			key = e.CreatedAt.Format("2006-01-02")
+		}
+		if key == "" {
+			key = "unknown"
+		}
+
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, eventGroup{Key: key})
+		}
+		groups[i].Events = append(groups[i].Events, e)
+	}
+
+	return groups
+}
+
+// summarizeGroup collapses a group's events into a single report line like
+// "12 commits across 3 pushes, 2 PRs opened, 1 merged".
+func summarizeGroup(events []Event) string {
+	var commits, pushes, prOpened, prMerged, prClosed, issuesOpened, issuesClosed, stars, forks int
+	other := map[string]int{}
+
+	for _, e := range events {
+		payload, _ := ParsePayload(e.Type, e.Payload)
+		switch p := payload.(type) {
+		case *PushPayload:
+			pushes++
+			commits += len(p.Commits)
+		case *PullRequestPayload:
+			switch {
+			case p.Action == "closed" && p.PullRequest.Merged:
+				prMerged++
+			case p.Action == "closed":
+				prClosed++
+			case p.Action == "opened":
+				prOpened++
+			default:
+				other["PRs "+p.Action]++
+			}
+		case *IssuesPayload:
+			switch p.Action {
+			case "opened":
+				issuesOpened++
+			case "closed":
+				issuesClosed++
+			default:
+				other["issues "+p.Action]++
+			}
+		default:
+			switch e.Type {
+			case "WatchEvent":
+				stars++
+			case "ForkEvent":
+				forks++
+			default:
+				other[e.Type]++
+			}
+		}
+	}
+
+	var parts []string
+	if pushes > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s across %d %s",
+			commits, pluralize(commits, "commit", "commits"),
+			pushes, pluralize(pushes, "push", "pushes")))
+	}
+	if prOpened > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s opened", prOpened, pluralize(prOpened, "PR", "PRs")))
+	}
+	if prMerged > 0 {
+		parts = append(parts, fmt.Sprintf("%d merged", prMerged))
+	}
+	if prClosed > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s closed", prClosed, pluralize(prClosed, "PR", "PRs")))
+	}
+	if issuesOpened > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s opened", issuesOpened, pluralize(issuesOpened, "issue", "issues")))
+	}
+	if issuesClosed > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s closed", issuesClosed, pluralize(issuesClosed, "issue", "issues")))
+	}
+	if stars > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s", stars, pluralize(stars, "star", "stars")))
+	}
+	if forks > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s", forks, pluralize(forks, "fork", "forks")))
+	}
+
+	keys := make([]string, 0, len(other))
+	for k := range other {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%d %s", other[k], k))
+	}
+
+	if len(parts) == 0 {
+		return "no notable activity"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func mustPayload(t *testing.T, v any) json.RawMessage {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	return data
+}
+
+func TestGroupEventsByRepoPreservesFirstSeenOrder(t *testing.T) {
+	events := []Event{
+		{Type: "PushEvent", Repo: Repo{Name: "b/repo"}},
+		{Type: "PushEvent", Repo: Repo{Name: "a/repo"}},
+		{Type: "IssuesEvent", Repo: Repo{Name: "b/repo"}},
+	}
+
+	groups := groupEvents(events, "repo")
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, want 2: %+v", len(groups), groups)
+	}
+	if groups[0].Key != "b/repo" || len(groups[0].Events) != 2 {
+		t.Errorf("groups[0] = %+v, want key b/repo with 2 events", groups[0])
+	}
+	if groups[1].Key != "a/repo" || len(groups[1].Events) != 1 {
+		t.Errorf("groups[1] = %+v, want key a/repo with 1 event", groups[1])
+	}
+}
+
+func TestGroupEventsByDayZeroTimeFormatsAsYearOne(t *testing.T) {
+	// groupEvents only falls back to "unknown" for an empty key, but
+	// CreatedAt.Format always produces a non-empty string, even for the
+	// zero time, so zero-time events land in their own "0001-01-01" group
+	// rather than "unknown".
+	events := []Event{{Type: "PushEvent", Repo: Repo{Name: "a/repo"}}}
+	groups := groupEvents(events, "day")
+	if len(groups) != 1 || groups[0].Key != "0001-01-01" {
+		t.Errorf("groups = %+v, want a single \"0001-01-01\" group for a zero CreatedAt", groups)
+	}
+}
+
+func TestGroupEventsByTypeEmptyKeyIsUnknown(t *testing.T) {
+	events := []Event{{Repo: Repo{Name: "a/repo"}}}
+	groups := groupEvents(events, "type")
+	if len(groups) != 1 || groups[0].Key != "unknown" {
+		t.Errorf("groups = %+v, want a single \"unknown\" group for an empty Type", groups)
+	}
+}
+
+func TestSummarizeGroup(t *testing.T) {
+	mergedPR := PullRequestPayload{Action: "closed"}
+	mergedPR.PullRequest.Merged = true
+
+	events := []Event{
+		{Type: "PushEvent", Payload: mustPayload(t, PushPayload{Commits: make([]struct {
+			SHA string `json:"sha"`
+		}, 3)})},
+		{Type: "PushEvent", Payload: mustPayload(t, PushPayload{})},
+		{Type: "PullRequestEvent", Payload: mustPayload(t, PullRequestPayload{Action: "opened"})},
+		{Type: "PullRequestEvent", Payload: mustPayload(t, mergedPR)},
+		{Type: "WatchEvent"},
+	}
+
+	got := summarizeGroup(events)
+	want := "3 commits across 2 pushes, 1 PR opened, 1 merged, 1 star"
+	if got != want {
+		t.Errorf("summarizeGroup() = %q, want %q", got, want)
+	}
+}
+
+func TestSummarizeGroupEmptyIsNoNotableActivity(t *testing.T) {
+	if got := summarizeGroup(nil); got != "no notable activity" {
+		t.Errorf("summarizeGroup(nil) = %q, want %q", got, "no notable activity")
+	}
+}
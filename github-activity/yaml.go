@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// printYAML writes events as a YAML sequence of mappings, one per event.
+// This is a small hand-rolled encoder rather than a dependency on a YAML
+// library: outputEvent's shape is fixed and simple enough (a few scalars
+// plus one nested payload map) that round-tripping it through
+// encoding/json and a generic map walker is enough to produce valid,
+// readable YAML, and this repo has no module file to pull a real one in.
+func printYAML(events []Event) error {
+	if len(events) == 0 {
+		fmt.Println("[]")
+		return nil
+	}
+	for _, oe := range toOutputEvents(events) {
+		fmt.Printf("- id: %s\n", yamlScalar(oe.ID))
+		fmt.Printf("  type: %s\n", yamlScalar(oe.Type))
+		fmt.Printf("  repo: %s\n", yamlScalar(oe.Repo))
+		if oe.CreatedAt != nil {
+			fmt.Printf("  created_at: %s\n", yamlScalar(oe.CreatedAt.Format(time.RFC3339)))
+		}
+		if oe.Payload == nil {
+			continue
+		}
+		fmt.Println("  payload:")
+		value, err := toYAMLValue(oe.Payload)
+		if err != nil {
+			return err
+		}
+		writeYAMLValue(value, "    ")
+	}
+	return nil
+}
+
+// toYAMLValue round-trips v through JSON to get a plain
+// map[string]any/[]any/scalar tree, since our payload types are always
+// pointers to the structs in events.go.
+func toYAMLValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func writeYAMLValue(v any, indent string) {
+	switch val := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			writeYAMLField(k, val[k], indent)
+		}
+	case []any:
+		for _, item := range val {
+			switch item.(type) {
+			case map[string]any, []any:
+				fmt.Printf("%s-\n", indent)
+				writeYAMLValue(item, indent+"  ")
+			default:
+				fmt.Printf("%s- %s\n", indent, yamlScalar(item))
+			}
+		}
+	default:
+		fmt.Printf("%s%s\n", indent, yamlScalar(val))
+	}
+}
+
+func writeYAMLField(key string, v any, indent string) {
+	switch v.(type) {
+	case map[string]any, []any:
+		fmt.Printf("%s%s:\n", indent, key)
+		writeYAMLValue(v, indent+"  ")
+	default:
+		fmt.Printf("%s%s: %s\n", indent, key, yamlScalar(v))
+	}
+}
+
+// yamlScalar renders a JSON scalar (string, float64, bool, or nil) as a
+// YAML scalar, quoting strings that would otherwise be read back as a
+// different type.
+func yamlScalar(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		if needsYAMLQuoting(val) {
+			return strconv.Quote(val)
+		}
+		return val
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		if val == float64(int64(val)) {
+			return strconv.FormatInt(int64(val), 10)
+		}
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+func needsYAMLQuoting(s string) bool {
+	if s == "" {
+		return true
+	}
+	if s != strings.TrimSpace(s) {
+		return true
+	}
+	// YAML 1.1 (which most parsers, including PyYAML's safe_load and go-yaml
+	// v2, still honor for bools) reads these case-insensitively as
+	// true/false/null regardless of our intent, so they all need quoting.
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "y", "n", "null", "~":
+		return true
+	}
+	if strings.ContainsAny(s, ":#{}[]&*!|>'\"%@`\n") {
+		return true
+	}
+	// "-", "?", and "," are only YAML indicator characters as the first
+	// character of a plain scalar (block sequence entry, mapping/complex
+	// key, and flow separator respectively); mid-string they're ordinary
+	// punctuation and don't need quoting.
+	switch s[0] {
+	case '-', '?', ',':
+		return true
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package main
+
+import "testing"
+
+func TestNeedsYAMLQuoting(t *testing.T) {
+	cases := []struct {
+		s    string
+		want bool
+	}{
+		{"", true},
+		{"hello", false},
+		{"true", true},
+		{"yes", true},
+		{"off", true},
+		{"y", true},
+		{"- do the thing", true},
+		{", leading comma", true},
+		{"? leading question mark", true},
+		{" leading space", true},
+		{"trailing space ", true},
+		{"owner/repo", false},
+		{"fix: bug", true},
+		{"octocat/my-awesome-repo", false},
+		{"Fixed a bug, added tests", false},
+		{"what is this?", false},
+	}
+
+	for _, tc := range cases {
+		if got := needsYAMLQuoting(tc.s); got != tc.want {
+			t.Errorf("needsYAMLQuoting(%q) = %v, want %v", tc.s, got, tc.want)
+		}
+	}
+}